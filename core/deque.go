@@ -0,0 +1,787 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"os"
+
+	"github.com/rs/zerolog"
+)
+
+// This is my implementation of a double-ended queue.
+// Deque (double-ended queue) is a data structure that supports insertion and deletion
+// operations at both ends. It combines the functionality of both stacks and queues,
+// allowing elements to be added or removed from either the front or back in constant O(1) time.
+// Unlike a standard queue (FIFO) or stack (LIFO), a deque provides maximum flexibility
+// for element access. Common use cases include sliding window problems, palindrome checking,
+// and task scheduling where elements need to be processed from either end.
+//
+// Implementation uses a dynamic circular buffer to maintain O(1) operations at both ends
+// while providing efficient memory usage and cache locality. When capacity is reached,
+// the buffer automatically grows to accommodate new elements, unless WithOverwrite was
+// passed to WithCapacity, in which case PushBack or PushFront onto a full Deque overwrites
+// the element at the opposite end instead of growing. PushBackSlice and PushFrontSlice
+// grow the buffer to fit whether or not WithOverwrite was passed.
+//
+// Deque is generic over its element type T. The capacity must be a power of two.
+type Deque[T any] struct {
+	buf             []T
+	count           int
+	head            int
+	tail            int
+	capacity        int
+	minCapacity     int
+	overwriteOnFull bool
+}
+
+var logger zerolog.Logger = zerolog.New(zerolog.ConsoleWriter{Out: os.Stderr}).With().Timestamp().Str("module", "core/deque").Logger()
+
+// A sufficiently large enough minimum capacity to prevent the buffer from having to grow too many times early on.
+const minCap = 1024
+
+// Private helper functions
+func initDeque[T any](q *Deque[T], capacity int) *Deque[T] {
+	if q == nil {
+		logger.Debug().Msg("Deque is a nil instance. Allocating memory and creating an instance.")
+
+		q = new(Deque[T])
+	}
+
+	if capacity == 0 {
+		logger.Debug().Msg("Deque has an empty buffer. Creating min capacity buffer and initializing internal pointers for head and tail.")
+		capacity = minCap
+	}
+	if q.minCapacity == 0 {
+		q.minCapacity = minCap
+	}
+	q.buf = make([]T, capacity)
+	q.head = 0
+	q.count = 0
+	q.tail = 0
+	q.capacity = capacity
+
+	return q
+}
+
+// Rounds capacity up to the next power of two. A no-op if it already is one.
+func nextPowerOfTwo(capacity int) int {
+	if capacity&(capacity-1) == 0 {
+		return capacity
+	}
+
+	capacity |= capacity >> 1
+	capacity |= capacity >> 2
+	capacity |= capacity >> 4
+	capacity |= capacity >> 8
+	capacity |= capacity >> 16
+	capacity++
+
+	return capacity
+}
+
+// Calculates the index pointer that follows reference
+func (q *Deque[T]) next(reference int) int {
+	index := 0
+
+	if q.count != 0 {
+		index = (reference + 1) & (len(q.buf) - 1)
+	}
+
+	logger.Debug().Int("index", index).Msg("Returning next index")
+	return index
+}
+
+// Calculates the index pointer that precedes reference
+func (q *Deque[T]) prev(reference int) int {
+	index := 0
+
+	if q.count != 0 {
+		index = (reference - 1) & (len(q.buf) - 1)
+	}
+
+	logger.Debug().Int("index", index).Msg("Returning previous index")
+	return index
+}
+
+// Updates the element count. Callers are expected to have already grown the
+// buffer if it was full, so the count is never bounded here.
+func (q *Deque[T]) updateCount() {
+	logger.Debug().Msg("Updating count")
+
+	q.count++
+}
+
+// Reallocates the buffer to newCapacity and re-lays the elements out
+// contiguously starting at index 0, preserving their logical head-to-tail
+// order. Shared by grow, shrink and ensureCapacityFor, which may need to
+// jump more than one doubling/halving step at once.
+func (q *Deque[T]) resizeTo(newCapacity int) {
+	newBuf := make([]T, newCapacity)
+	for i := 0; i < q.count; i++ {
+		newBuf[i] = q.buf[(q.head+i)&(len(q.buf)-1)]
+	}
+
+	q.buf = newBuf
+	q.head = 0
+	if q.count == 0 {
+		q.tail = 0
+	} else {
+		q.tail = q.count - 1
+	}
+	q.capacity = newCapacity
+}
+
+// Doubles the buffer capacity, preserving logical head-to-tail order. Called
+// whenever a push would otherwise have to overwrite the opposite end.
+func (q *Deque[T]) grow() {
+	newCapacity := len(q.buf) * 2
+	logger.Debug().Int("old capacity", len(q.buf)).Int("new capacity", newCapacity).Msg("Deque is at capacity. Growing buffer.")
+
+	q.resizeTo(newCapacity)
+
+	logger.Debug().Int("head", q.head).Int("tail", q.tail).Int("capacity", q.capacity).Msg("Deque state after growing.")
+}
+
+// Halves the buffer capacity, mirroring grow. Called whenever a pop leaves
+// the deque mostly empty in a buffer well above its configured floor.
+func (q *Deque[T]) shrink() {
+	newCapacity := len(q.buf) / 2
+	logger.Debug().Int("old capacity", len(q.buf)).Int("new capacity", newCapacity).Msg("Deque is mostly empty. Shrinking buffer.")
+
+	q.resizeTo(newCapacity)
+
+	logger.Debug().Int("head", q.head).Int("tail", q.tail).Int("capacity", q.capacity).Msg("Deque state after shrinking.")
+}
+
+// Shrinks the buffer in half when the deque has drained to a quarter of its
+// capacity, so long as that still leaves it at or above its configured floor.
+func (q *Deque[T]) maybeShrink() {
+	if q.count <= len(q.buf)/4 && len(q.buf) > q.minCapacity {
+		q.shrink()
+	}
+}
+
+// Grows the buffer in a single step to the next power of two that fits
+// count+added elements, if it isn't already big enough. Used by the bulk
+// push helpers so a single call only ever reallocates once, rather than
+// once per doubling as repeated PushBack/PushFront calls would.
+func (q *Deque[T]) ensureCapacityFor(added int) {
+	needed := nextPowerOfTwo(q.count + added)
+	if needed > len(q.buf) {
+		logger.Debug().Int("old capacity", len(q.buf)).Int("new capacity", needed).Msg("Growing buffer in one step to fit a bulk push")
+		q.resizeTo(needed)
+	}
+}
+
+// Option configures optional behavior on a Deque created via WithCapacity.
+type Option[T any] func(*Deque[T])
+
+// WithOverwrite configures PushBack and PushFront to overwrite the element
+// at the opposite end instead of growing once the buffer is full, restoring
+// the plain ring-buffer semantics used before automatic growth was
+// introduced. The bulk PushBackSlice and PushFrontSlice still grow.
+func WithOverwrite[T any]() Option[T] {
+	return func(q *Deque[T]) {
+		q.overwriteOnFull = true
+	}
+}
+
+func WithCapacity[T any](capacity int, opts ...Option[T]) *Deque[T] {
+	logger.Debug().Int("capacity", capacity).Msg("Checking if given capacity is a power of 2")
+	capacity = nextPowerOfTwo(capacity)
+
+	logger.Debug().Msgf("Creating a deque with capacity %d", capacity)
+	q := initDeque[T](nil, capacity)
+	for _, opt := range opts {
+		opt(q)
+	}
+
+	return q
+}
+
+// SetMinCapacity sets the floor the buffer will never shrink below to
+// 1<<minExp, rounded up to the next power of two, replacing the
+// package-level minCap default for this deque.
+func (q *Deque[T]) SetMinCapacity(minExp uint) {
+	if q == nil {
+		logger.Debug().Msg("SetMinCapacity called on a nil Deque. Ignoring.")
+		return
+	}
+
+	q.minCapacity = nextPowerOfTwo(1 << minExp)
+	logger.Debug().Int("min capacity", q.minCapacity).Msg("Set new shrink floor")
+}
+
+func (q *Deque[T]) Cap() int {
+	logger.Debug().Msg("Requesting capacity of deque")
+
+	if q == nil {
+		logger.Debug().Str("Deque", fmt.Sprintf("%v", q)).Msg("Deque provided to Cap() is a nil instance.")
+		return 0
+	}
+
+	logger.Debug().Int("Capacity", len(q.buf)).Msg("Returning the capacity of the calling deque.")
+	return len(q.buf)
+}
+
+func (q *Deque[T]) Len() int {
+	logger.Debug().Msg("Requesting length of deque")
+
+	if q == nil {
+		logger.Debug().Str("Deque", fmt.Sprintf("%v", q)).Msg("Deque provided to Len() is a nil instance.")
+		return 0
+	}
+
+	logger.Debug().Int("Length", q.count).Msg("Returning the length of the calling deque.")
+	return q.count
+}
+
+func (q *Deque[T]) PushBack(data T) {
+	logger.Debug().Interface("data", data).Msg("Pushing an item to the tail of the deque")
+
+	if q == nil || len(q.buf) == 0 {
+		logger.Debug().Msg("Deque is nil or empty. Initializing.")
+		initDeque(q, 0)
+	}
+
+	full := q.count == len(q.buf)
+	if full {
+		if q.overwriteOnFull {
+			logger.Debug().Msg("Deque is at capacity with overwrite enabled. Head element will be overwritten.")
+		} else {
+			q.grow()
+			full = false
+		}
+	}
+
+	logger.Debug().Interface("buffer", q.buf).Msg("Pre-push buffer state")
+	index := q.next(q.tail)
+	q.buf[index] = data
+	if q.count == 0 {
+		q.head = index
+	} else if full {
+		q.head = q.next(q.head)
+	}
+	q.tail = index
+	if full {
+		logger.Debug().Msg("Overwrote the head element. Count stays at capacity.")
+	} else {
+		q.updateCount()
+	}
+
+	logger.Debug().
+		Interface("buffer", q.buf).
+		Int("head", q.head).
+		Int("tail", q.tail).
+		Int("count", q.count).
+		Msg("Post-push buffer state")
+}
+
+func (q *Deque[T]) PopBack() (T, error) {
+	var zero T
+
+	if q == nil {
+		err := errors.New("Illegal pop back operation on nil deque.")
+		logger.Error().Msg(err.Error())
+		return zero, err
+	}
+
+	logger.Debug().Msg("Removing the last element. Tail shrinks.")
+
+	if q.count != 0 {
+		tail := q.Tail()
+		if q.count == 1 {
+			logger.Debug().Msg("case: item count is 1")
+			q.tail = 0
+		} else if q.tail < q.head {
+			logger.Debug().Msg("case: item count is greater than 1 and tail is behind head")
+			q.tail = q.prev(q.tail)
+		} else {
+			logger.Debug().Msg("case: item count is greater than 1 and tail is ahead of head")
+			q.tail = q.prev(q.tail)
+		}
+		q.count--
+		q.maybeShrink()
+
+		logger.Debug().
+			Int("count", q.count).
+			Int("head", q.head).
+			Int("tail", q.tail).
+			Interface("buffer", q.buf).
+			Msg("Item count is greater than 0. Post pop state.")
+
+		return tail, nil
+	} else {
+		logger.Debug().
+			Int("count", q.count).
+			Int("head", q.head).
+			Int("tail", q.tail).
+			Interface("buffer", q.buf).
+			Msg("Item count is 0. Post pop state.")
+
+		return zero, nil
+	}
+}
+
+func (q *Deque[T]) PushFront(data T) {
+	logger.Debug().Interface("data", data).Msg("Pushing an item to the front")
+
+	if q == nil || len(q.buf) == 0 {
+		logger.Debug().Msg("Deque is nil or empty. Initializing.")
+		initDeque(q, 0)
+	}
+
+	full := q.count == len(q.buf)
+	if full {
+		if q.overwriteOnFull {
+			logger.Debug().Msg("Deque is at capacity with overwrite enabled. Tail element will be overwritten.")
+		} else {
+			q.grow()
+			full = false
+		}
+	}
+
+	logger.Debug().Interface("buffer", q.buf).Msg("Pre-push buffer state")
+	index := q.prev(q.head)
+	q.buf[index] = data
+	if q.count == 0 {
+		q.tail = index
+	} else if full {
+		q.tail = q.prev(q.tail)
+	}
+	q.head = index
+	if full {
+		logger.Debug().Msg("Overwrote the tail element. Count stays at capacity.")
+	} else {
+		q.updateCount()
+	}
+
+	logger.Debug().
+		Interface("buffer", q.buf).
+		Int("head", q.head).
+		Int("tail", q.tail).
+		Int("count", q.count).
+		Msg("Post-push buffer state")
+}
+
+func (q *Deque[T]) PopFront() (T, error) {
+	var zero T
+
+	if q == nil {
+		err := errors.New("Illegal PopFront on nil deque.")
+		logger.Error().Msg(err.Error())
+		return zero, err
+	}
+
+	logger.Debug().Msg("Removing the item at the current head. Head shrinks.")
+
+	if q.count != 0 {
+		head := q.Head()
+		if q.count == 1 {
+			q.head = 0
+		} else {
+			q.head = q.next(q.head)
+		}
+		q.count--
+		q.maybeShrink()
+		return head, nil
+	} else {
+		return zero, nil
+	}
+}
+
+// Head returns the element at the front of the deque, or the zero value of T
+// if the deque is nil or empty.
+func (q *Deque[T]) Head() T {
+	var zero T
+	if q == nil || q.count == 0 {
+		logger.Debug().Msg("Head requested on a nil or empty Deque. Returning zero value.")
+		return zero
+	}
+
+	logger.Debug().Msgf("Returning element at head = %d", q.head)
+	return q.buf[q.head]
+}
+
+// Tail returns the element at the back of the deque, or the zero value of T
+// if the deque is nil or empty.
+func (q *Deque[T]) Tail() T {
+	var zero T
+	if q == nil || q.count == 0 {
+		logger.Debug().Msg("Tail requested on a nil or empty Deque. Returning zero value.")
+		return zero
+	}
+
+	logger.Debug().Msgf("Returning element at tail = %d", q.tail)
+	return q.buf[q.tail]
+}
+
+// Contains reports whether key is present in the deque. Since T is any
+// rather than comparable, the caller supplies cmp to compare elements for
+// equality.
+func (q *Deque[T]) Contains(key T, cmp func(a, b T) bool) bool {
+	logger.Debug().Interface("key", key).Msg("Looking for key in deque")
+
+	for i := 0; i < q.count; i++ {
+		if cmp(q.buf[(q.head+i)&(len(q.buf)-1)], key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// At returns the element at logical index i, where At(0) == Head() and
+// At(Len()-1) == Tail(). Panics if i is out of range.
+func (q *Deque[T]) At(i int) T {
+	if i < 0 || i >= q.Len() {
+		panic(fmt.Sprintf("core: Deque.At: index out of range [%d] with length %d", i, q.Len()))
+	}
+
+	logger.Debug().Int("index", i).Msg("Returning element at logical index")
+	return q.buf[(q.head+i)&(len(q.buf)-1)]
+}
+
+// Set writes v at logical index i, using the same indexing as At. Panics if
+// i is out of range.
+func (q *Deque[T]) Set(i int, v T) {
+	if i < 0 || i >= q.Len() {
+		panic(fmt.Sprintf("core: Deque.Set: index out of range [%d] with length %d", i, q.Len()))
+	}
+
+	logger.Debug().Int("index", i).Interface("value", v).Msg("Setting element at logical index")
+	q.buf[(q.head+i)&(len(q.buf)-1)] = v
+}
+
+// Insert inserts v at logical index i, valid for 0..Len(). It pushes onto
+// whichever end is closer and rotates the new element into place by hand,
+// keeping the cost proportional to min(i, Len()-i) instead of always
+// shifting the whole deque.
+func (q *Deque[T]) Insert(i int, v T) {
+	count := q.Len()
+	if i < 0 || i > count {
+		panic(fmt.Sprintf("core: Deque.Insert: index out of range [%d] with length %d", i, count))
+	}
+
+	logger.Debug().Int("index", i).Interface("value", v).Msg("Inserting element at logical index")
+
+	if i < count/2 {
+		q.PushFront(v)
+		for j := 0; j < i; j++ {
+			q.Set(j, q.At(j+1))
+		}
+		q.Set(i, v)
+	} else {
+		q.PushBack(v)
+		for j := q.Len() - 1; j > i; j-- {
+			q.Set(j, q.At(j-1))
+		}
+		q.Set(i, v)
+	}
+}
+
+// Remove removes and returns the element at logical index i, valid for
+// 0..Len()-1. It is the inverse of Insert: the shorter side is rotated over
+// the gap left by i, then the now-duplicated element is popped off.
+func (q *Deque[T]) Remove(i int) T {
+	count := q.Len()
+	if i < 0 || i >= count {
+		panic(fmt.Sprintf("core: Deque.Remove: index out of range [%d] with length %d", i, count))
+	}
+
+	logger.Debug().Int("index", i).Msg("Removing element at logical index")
+
+	removed := q.At(i)
+
+	if i < count/2 {
+		for j := i; j > 0; j-- {
+			q.Set(j, q.At(j-1))
+		}
+		q.PopFront()
+	} else {
+		for j := i; j < count-1; j++ {
+			q.Set(j, q.At(j+1))
+		}
+		q.PopBack()
+	}
+
+	return removed
+}
+
+// Rotate rotates the deque by n logical positions: a positive n moves the
+// first n elements to the back, a negative n moves the last -n elements to
+// the front, and n is taken mod Len(). When the buffer is completely full
+// every slot holds a real element, so head and tail can simply be shifted
+// modulo len(buf) in O(1), the classic ring-buffer trick. The buffer
+// otherwise generally has spare capacity beyond Len() to amortize
+// grow/shrink, so head and tail can't be shifted the same way without
+// exposing unused slots as if they held real elements; Rotate instead saves
+// off the shorter side and rewrites every element in place with At/Set. In
+// that case the cost is proportional to Len(), not O(1); only the temporary
+// copy of the shorter side is bounded by min(n, Len()-n).
+func (q *Deque[T]) Rotate(n int) {
+	count := q.Len()
+	if count == 0 {
+		return
+	}
+
+	r := n % count
+	if r < 0 {
+		r += count
+	}
+	if r == 0 {
+		return
+	}
+
+	logger.Debug().Int("n", n).Int("normalized", r).Msg("Rotating deque")
+
+	if count == len(q.buf) {
+		mask := len(q.buf) - 1
+		q.head = (q.head + r) & mask
+		q.tail = (q.tail + r) & mask
+		return
+	}
+
+	if r <= count-r {
+		saved := make([]T, r)
+		for i := 0; i < r; i++ {
+			saved[i] = q.At(i)
+		}
+		for i := 0; i < count-r; i++ {
+			q.Set(i, q.At(i+r))
+		}
+		for i := 0; i < r; i++ {
+			q.Set(count-r+i, saved[i])
+		}
+	} else {
+		k := count - r
+		saved := make([]T, k)
+		for i := 0; i < k; i++ {
+			saved[i] = q.At(count - k + i)
+		}
+		for i := count - 1; i >= k; i-- {
+			q.Set(i, q.At(i-k))
+		}
+		for i := 0; i < k; i++ {
+			q.Set(i, saved[i])
+		}
+	}
+}
+
+// Iter returns a range-over-func iterator that walks the deque from head to
+// tail in logical order, reading directly out of the backing buffer without
+// copying it. The deque should not be mutated while iterating.
+func (q *Deque[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if q == nil {
+			return
+		}
+
+		logger.Debug().Msg("Iterating deque head to tail")
+
+		for i := 0; i < q.count; i++ {
+			if !yield(q.buf[(q.head+i)&(len(q.buf)-1)]) {
+				return
+			}
+		}
+	}
+}
+
+// IterBack mirrors Iter, walking from tail to head.
+func (q *Deque[T]) IterBack() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if q == nil {
+			return
+		}
+
+		logger.Debug().Msg("Iterating deque tail to head")
+
+		for i := q.count - 1; i >= 0; i-- {
+			if !yield(q.buf[(q.head+i)&(len(q.buf)-1)]) {
+				return
+			}
+		}
+	}
+}
+
+// PushBackSlice appends vals to the back of the deque in order. It grows the
+// buffer at most once, to the next power of two that fits all of vals, and
+// copies them into the ring in at most two copy calls across the wrap
+// point, rather than growing and bounds-checking once per element the way a
+// loop of PushBack calls would.
+func (q *Deque[T]) PushBackSlice(vals []T) {
+	if len(vals) == 0 {
+		return
+	}
+
+	logger.Debug().Int("count", len(vals)).Msg("Pushing a slice to the tail of the deque")
+
+	if q == nil || len(q.buf) == 0 {
+		initDeque(q, 0)
+	}
+	q.ensureCapacityFor(len(vals))
+
+	mask := len(q.buf) - 1
+	start := q.next(q.tail)
+
+	first := copy(q.buf[start:], vals)
+	if first < len(vals) {
+		copy(q.buf, vals[first:])
+	}
+
+	if q.count == 0 {
+		q.head = start
+	}
+	q.tail = (start + len(vals) - 1) & mask
+	q.count += len(vals)
+}
+
+// PushFrontSlice prepends vals to the front of the deque in order, so that
+// after the call vals[0] is the new head. It grows the buffer at most once
+// and copies in at most two copy calls, mirroring PushBackSlice.
+func (q *Deque[T]) PushFrontSlice(vals []T) {
+	if len(vals) == 0 {
+		return
+	}
+
+	logger.Debug().Int("count", len(vals)).Msg("Pushing a slice to the front of the deque")
+
+	if q == nil || len(q.buf) == 0 {
+		initDeque(q, 0)
+	}
+	q.ensureCapacityFor(len(vals))
+
+	mask := len(q.buf) - 1
+	start := 0
+	if q.count != 0 {
+		start = (q.head - len(vals)) & mask
+	}
+
+	first := copy(q.buf[start:], vals)
+	if first < len(vals) {
+		copy(q.buf, vals[first:])
+	}
+
+	if q.count == 0 {
+		q.tail = (start + len(vals) - 1) & mask
+	}
+	q.head = start
+	q.count += len(vals)
+}
+
+// PopFrontN removes and returns the first n elements in logical order,
+// clamped to Len() if n is larger. The shrink heuristic is applied once at
+// the end rather than after each element.
+func (q *Deque[T]) PopFrontN(n int) []T {
+	if q == nil || n <= 0 {
+		return nil
+	}
+	if n > q.count {
+		n = q.count
+	}
+	if n == 0 {
+		return nil
+	}
+
+	logger.Debug().Int("n", n).Msg("Popping n items from the front of the deque")
+
+	out := make([]T, n)
+	first := copy(out, q.buf[q.head:])
+	if first < n {
+		copy(out[first:], q.buf[:n-first])
+	}
+
+	q.head = (q.head + n) & (len(q.buf) - 1)
+	q.count -= n
+	if q.count == 0 {
+		q.head = 0
+		q.tail = 0
+	}
+	q.maybeShrink()
+
+	return out
+}
+
+// PopBackN removes and returns the last n elements in logical order,
+// clamped to Len() if n is larger. The shrink heuristic is applied once at
+// the end rather than after each element.
+func (q *Deque[T]) PopBackN(n int) []T {
+	if q == nil || n <= 0 {
+		return nil
+	}
+	if n > q.count {
+		n = q.count
+	}
+	if n == 0 {
+		return nil
+	}
+
+	logger.Debug().Int("n", n).Msg("Popping n items from the back of the deque")
+
+	mask := len(q.buf) - 1
+	start := (q.head + q.count - n) & mask
+
+	out := make([]T, n)
+	first := copy(out, q.buf[start:])
+	if first < n {
+		copy(out[first:], q.buf[:n-first])
+	}
+
+	q.count -= n
+	if q.count == 0 {
+		q.head = 0
+		q.tail = 0
+	} else {
+		q.tail = (start - 1) & mask
+	}
+	q.maybeShrink()
+
+	return out
+}
+
+// Slice returns a newly allocated slice containing the deque's elements in
+// logical order (head first).
+func (q *Deque[T]) Slice() []T {
+	if q == nil || q.count == 0 {
+		return nil
+	}
+
+	logger.Debug().Int("count", q.count).Msg("Snapshotting deque into a slice")
+
+	out := make([]T, q.count)
+	first := copy(out, q.buf[q.head:])
+	if first < q.count {
+		copy(out[first:], q.buf[:q.count-first])
+	}
+
+	return out
+}
+
+// Clear empties the deque, zeroing the backing buffer and resetting head,
+// tail and count. If the buffer had grown past the configured floor, it is
+// also reallocated back down to it.
+func (q *Deque[T]) Clear() {
+	if q == nil {
+		logger.Debug().Msg("Clear called on a nil Deque. Ignoring.")
+		return
+	}
+
+	logger.Debug().Msg("Clearing deque")
+
+	var zero T
+	for i := range q.buf {
+		q.buf[i] = zero
+	}
+	q.head = 0
+	q.tail = 0
+	q.count = 0
+
+	if q.minCapacity == 0 {
+		q.minCapacity = minCap
+	}
+	if len(q.buf) > q.minCapacity {
+		q.buf = make([]T, q.minCapacity)
+		q.capacity = q.minCapacity
+	}
+}
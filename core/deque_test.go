@@ -2,9 +2,13 @@ package core
 
 import "testing"
 
-func setupDequeWithItems() (*Deque, int) {
+func intEq(a, b int) bool {
+	return a == b
+}
+
+func setupDequeWithItems() (*Deque[int], int) {
 	capacity := 8
-	q := WithCapacity(capacity)
+	q := WithCapacity[int](capacity)
 	vals := []int{1, 2, 3, 4, 5, 6, 7, 8}
 	for _, item := range vals {
 		q.PushBack(item)
@@ -13,21 +17,21 @@ func setupDequeWithItems() (*Deque, int) {
 }
 
 func TestCapNil(t *testing.T) {
-	var q *Deque = nil
+	var q *Deque[int] = nil
 	if q.Cap() != 0 {
 		t.Errorf("Expected 0 capacity in a nil Deque, found %v", q.Cap())
 	}
 }
 
 func TestLenNil(t *testing.T) {
-	var q *Deque = nil
+	var q *Deque[int] = nil
 	if q.Len() != 0 {
 		t.Errorf("Expected 0 length in a nil Deque, found %v", q.Len())
 	}
 }
 
 func TestLenCapPushBackTail(t *testing.T) {
-	q := new(Deque)
+	q := new(Deque[int])
 	val := 10
 	q.PushBack(val)
 	if q.Len() != 1 {
@@ -48,7 +52,7 @@ func TestLenCapPushBackTail(t *testing.T) {
 
 func TestLenCapPushBackHeadTailFull(t *testing.T) {
 	capacity := 4
-	q := WithCapacity(capacity)
+	q := WithCapacity[int](capacity)
 	val, val2, val3, val4 := 1, 2, 3, 4
 	q.PushBack(val)
 	q.PushBack(val2)
@@ -67,51 +71,86 @@ func TestLenCapPushBackHeadTailFull(t *testing.T) {
 	}
 }
 
-func TestLenCapPushBackHeadTailOverCap(t *testing.T) {
+func TestPushBackGrowsInsteadOfOverwriting(t *testing.T) {
 	capacity := 4
-	q := WithCapacity(capacity)
+	q := WithCapacity[int](capacity)
 	val, val2, val3, val4, val5 := 1, 2, 3, 4, 6
 	q.PushBack(val)
 	q.PushBack(val2)
 	q.PushBack(val3)
 	q.PushBack(val4)
 	q.PushBack(val5)
-	if q.Len() != q.capacity {
-		t.Errorf("Expected length to be at capacity, but found %d", q.Len())
+	if q.Len() != 5 {
+		t.Errorf("Expected length to grow past the starting capacity to 5, but found %d", q.Len())
+	}
+	if q.Cap() != 2*capacity {
+		t.Errorf("Expected the capacity to double to %d, but found %d", 2*capacity, q.Cap())
 	}
 	head := q.Head()
-	if head != val2 {
-		t.Errorf("Expected the head item to be %d but found %d", val, head)
+	if head != val {
+		t.Errorf("Expected the head item to still be %d but found %d", val, head)
 	}
 	tail := q.Tail()
 	if tail != val5 {
-		t.Errorf("Expected the tail item to be %d but found %d", val4, tail)
+		t.Errorf("Expected the tail item to be %d but found %d", val5, tail)
 	}
-	if q.Contains(val) {
-		t.Errorf("Expected the deque not to contain the overwritten previous head, but found %d", val)
+	if !q.Contains(val, intEq) {
+		t.Errorf("Expected the deque to still contain the original head %d after growing, but it did not", val)
 	}
 }
 
-func TestOverflowTwice(t *testing.T) {
+func TestOverflowTwiceGrows(t *testing.T) {
 	capacity := 3
-	q := WithCapacity(capacity)
+	q := WithCapacity[int](capacity)
 	vals := []int{1, 2, 3, 4, 5, 6}
 	for i := 0; i < 6; i++ {
 		q.PushBack(vals[i])
 	}
+	if q.Len() != 6 {
+		t.Errorf("Expected length %d, but found %d", 6, q.Len())
+	}
 	head := q.Head()
-	if head != 3 {
-		t.Errorf("Expected head to be the %d, but found %d", 3, head)
+	if head != vals[0] {
+		t.Errorf("Expected head to still be %d, but found %d", vals[0], head)
 	}
 	tail := q.Tail()
 	if tail != vals[5] {
-		t.Errorf("Expected the tail to be the last item in the overflowed array, but found %d", tail)
+		t.Errorf("Expected the tail to be the last item pushed, but found %d", tail)
+	}
+	if !q.Contains(vals[0], intEq) {
+		t.Errorf("Expected the deque to still contain %d after growing twice, but it did not", vals[0])
+	}
+}
+
+func TestPushBackOverwritesHeadWhenConfigured(t *testing.T) {
+	capacity := 4
+	q := WithCapacity[int](capacity, WithOverwrite[int]())
+	vals := []int{1, 2, 3, 4, 5}
+	for _, item := range vals {
+		q.PushBack(item)
+	}
+	if q.Len() != capacity {
+		t.Errorf("Expected length to stay at capacity %d, but found %d", capacity, q.Len())
+	}
+	if q.Cap() != capacity {
+		t.Errorf("Expected the capacity to stay at %d, but found %d", capacity, q.Cap())
+	}
+	head := q.Head()
+	if head != vals[1] {
+		t.Errorf("Expected the head item to be overwritten to %d but found %d", vals[1], head)
+	}
+	tail := q.Tail()
+	if tail != vals[4] {
+		t.Errorf("Expected the tail item to be %d but found %d", vals[4], tail)
+	}
+	if q.Contains(vals[0], intEq) {
+		t.Errorf("Expected the original head %d to have been overwritten, but the deque still contains it", vals[0])
 	}
 }
 
 func TestPushFrontHeadTailLen(t *testing.T) {
 	val := 10
-	q := new(Deque)
+	q := new(Deque[int])
 	q.PushFront(val)
 	if q.Len() != 1 {
 		t.Errorf("Expected a length of 1 when pushed one item to the front, found %d", q.Len())
@@ -128,7 +167,7 @@ func TestPushFrontHeadTailLen(t *testing.T) {
 
 func TestPushFrontTwiceCorrectHeadCorrectTail(t *testing.T) {
 	val, val2 := 10, 20
-	q := new(Deque)
+	q := new(Deque[int])
 	q.PushFront(val)
 	q.PushFront(val2)
 	if q.Len() != 2 {
@@ -146,7 +185,7 @@ func TestPushFrontTwiceCorrectHeadCorrectTail(t *testing.T) {
 
 func TestPushFrontFullLen(t *testing.T) {
 	capacity := 4
-	q := WithCapacity(capacity)
+	q := WithCapacity[int](capacity)
 	vals := []int{1, 2, 3, 4}
 	for _, item := range vals {
 		q.PushFront(item)
@@ -164,46 +203,81 @@ func TestPushFrontFullLen(t *testing.T) {
 	}
 }
 
-func TestPushFrontOverCap(t *testing.T) {
+func TestPushFrontOverCapGrows(t *testing.T) {
 	capacity := 4
-	q := WithCapacity(capacity)
+	q := WithCapacity[int](capacity)
 	vals := []int{1, 2, 3, 4, 5}
 	for _, item := range vals {
 		q.PushFront(item)
 	}
-	if q.Len() != capacity {
-		t.Errorf("Expected length of %d but found %d", capacity, q.Len())
+	if q.Len() != len(vals) {
+		t.Errorf("Expected length of %d but found %d", len(vals), q.Len())
+	}
+	if q.Cap() != 2*capacity {
+		t.Errorf("Expected the capacity to double to %d, but found %d", 2*capacity, q.Cap())
 	}
 	head := q.Head()
 	if head != vals[4] {
 		t.Errorf("Expected head item to be %d but found %d", vals[4], head)
 	}
 	tail := q.Tail()
-	if tail != vals[1] {
-		t.Errorf("Expected tail item to be %d but found %d", vals[1], tail)
+	if tail != vals[0] {
+		t.Errorf("Expected tail item to still be %d but found %d", vals[0], tail)
+	}
+	if !q.Contains(vals[0], intEq) {
+		t.Errorf("Expected the deque to still contain the original tail %d after growing, but it did not", vals[0])
 	}
 }
 
-func TestPushFrontOverCapTwice(t *testing.T) {
+func TestPushFrontOverCapTwiceGrows(t *testing.T) {
 	capacity := 3
-	q := WithCapacity(capacity)
+	q := WithCapacity[int](capacity)
 	vals := []int{1, 2, 3, 4, 5, 6}
 	for _, item := range vals {
 		q.PushFront(item)
 	}
+	if q.Len() != len(vals) {
+		t.Errorf("Expected length of %d but found %d", len(vals), q.Len())
+	}
 	head := q.Head()
 	tail := q.Tail()
 	if head != 6 {
 		t.Errorf("Expected the head item to be %d but found %d", 6, head)
 	}
-	if tail != 3 {
-		t.Errorf("Expected the tail item to be %d but found %d", 4, tail)
+	if tail != vals[0] {
+		t.Errorf("Expected the tail item to still be %d but found %d", vals[0], tail)
+	}
+}
+
+func TestPushFrontOverwritesTailWhenConfigured(t *testing.T) {
+	capacity := 4
+	q := WithCapacity[int](capacity, WithOverwrite[int]())
+	vals := []int{1, 2, 3, 4, 5}
+	for _, item := range vals {
+		q.PushFront(item)
+	}
+	if q.Len() != capacity {
+		t.Errorf("Expected length to stay at capacity %d, but found %d", capacity, q.Len())
+	}
+	if q.Cap() != capacity {
+		t.Errorf("Expected the capacity to stay at %d, but found %d", capacity, q.Cap())
+	}
+	head := q.Head()
+	if head != vals[4] {
+		t.Errorf("Expected the head item to be %d but found %d", vals[4], head)
+	}
+	tail := q.Tail()
+	if tail != vals[1] {
+		t.Errorf("Expected the tail item to be overwritten to %d but found %d", vals[1], tail)
+	}
+	if q.Contains(vals[0], intEq) {
+		t.Errorf("Expected the original tail %d to have been overwritten, but the deque still contains it", vals[0])
 	}
 }
 
 func TestPushFrontPushBackSimult(t *testing.T) {
 	val, val2 := 10, 20
-	q := new(Deque)
+	q := new(Deque[int])
 	q.PushBack(val)
 	q.PushFront(val2)
 	head := q.Head()
@@ -242,7 +316,7 @@ func TestPopBackLenCap(t *testing.T) {
 }
 
 func TestPopBackNilPointer(t *testing.T) {
-	var q *Deque = nil
+	var q *Deque[int] = nil
 	_, err := q.PopBack()
 	if err == nil {
 		t.Errorf("Expected error when PopBack called on nil Deque, instead found %v", err)
@@ -255,8 +329,11 @@ func TestOverFlowPopBack(t *testing.T) {
 	oldTail, err := q.PopBack()
 	head := q.Head()
 	tail := q.Tail()
-	if q.Len() != capacity-1 {
-		t.Errorf("Expected length %d but found %d", 7, q.Len())
+	if q.Cap() != 2*capacity {
+		t.Errorf("Expected the capacity to have grown to %d but found %d", 2*capacity, q.Cap())
+	}
+	if q.Len() != capacity {
+		t.Errorf("Expected length %d but found %d", capacity, q.Len())
 	}
 	if oldTail != 9 {
 		t.Errorf("Expected old tail item to be %d but found %d", 9, oldTail)
@@ -267,13 +344,13 @@ func TestOverFlowPopBack(t *testing.T) {
 	if tail != 8 {
 		t.Errorf("Expected new tail item to be %d, but found %d", 8, tail)
 	}
-	if head != 2 {
-		t.Errorf("Expected the head to be %d but found %d", 2, head)
+	if head != 1 {
+		t.Errorf("Expected the head to be unaffected at %d but found %d", 1, head)
 	}
 }
 
 func TestPopFrontNilPointer(t *testing.T) {
-	var q *Deque = nil
+	var q *Deque[int] = nil
 	_, err := q.PopFront()
 	if err == nil {
 		t.Errorf("Expected error when popping the front of a nil deque but found %v", err)
@@ -300,25 +377,28 @@ func TestPopFrontLenCap(t *testing.T) {
 }
 
 func TestPopFrontOverflow(t *testing.T) {
-	q, _ := setupDequeWithItems()
-	q.PushFront(9) // At this point the head should be this
+	q, capacity := setupDequeWithItems()
+	q.PushFront(9) // Pushed onto a full deque, so the buffer grows rather than overwriting the tail
 	oldHead := q.Head()
 	head, _ := q.PopFront()
 	newHead := q.Head()
 	tail := q.Tail()
+	if q.Cap() != 2*capacity {
+		t.Errorf("Expected the capacity to have grown to %d but found %d", 2*capacity, q.Cap())
+	}
 	if head != oldHead {
 		t.Errorf("Expected %d to be popped off but found %d", oldHead, head)
 	}
 	if newHead != 1 {
 		t.Errorf("Expected the new head to be %d but found %d", 1, newHead)
 	}
-	if tail != 7 {
-		t.Errorf("Expected the tail to be same at %d but found %d", 7, tail)
+	if tail != 8 {
+		t.Errorf("Expected the tail to be unaffected at %d but found %d", 8, tail)
 	}
 }
 
 func TestPopBackSingleItem(t *testing.T) {
-	q := new(Deque)
+	q := new(Deque[int])
 	val := 10
 	q.PushBack(val)
 	tail, _ := q.PopBack()
@@ -331,7 +411,7 @@ func TestPopBackSingleItem(t *testing.T) {
 }
 
 func TestPopFrontSingleItem(t *testing.T) {
-	q := new(Deque)
+	q := new(Deque[int])
 	val := 10
 	q.PushBack(val)
 	head, _ := q.PopFront()
@@ -371,11 +451,11 @@ func TestPopBackPopFrontTillEmptyThenAddOnceLenCapHeadTail(t *testing.T) {
 		t.Errorf("Expected tail to be %d but found %d", 3, tail)
 	}
 
-	if !q.Contains(1) {
+	if !q.Contains(1, intEq) {
 		t.Error("Should contain 1")
 	}
 
-	if q.Contains(8) {
+	if q.Contains(8, intEq) {
 		t.Error("Should not contain 8")
 	}
 }
@@ -401,3 +481,692 @@ func TestPopBackPushBack(t *testing.T) {
 		t.Errorf("The new head item should be %d but found %d", val, tail)
 	}
 }
+
+func TestShrinkHalvesCapacityDownToFloor(t *testing.T) {
+	q := WithCapacity[int](4)
+	q.SetMinCapacity(0) // floor of 1, so shrinking isn't bounded by the default minCap
+	for _, v := range []int{1, 2, 3, 4, 5} {
+		q.PushBack(v)
+	}
+	if q.Cap() != 8 {
+		t.Errorf("Expected the capacity to have grown to %d but found %d", 8, q.Cap())
+	}
+
+	q.PopBack()
+	q.PopBack()
+	q.PopBack()
+	if q.Cap() != 4 {
+		t.Errorf("Expected the capacity to shrink to %d but found %d", 4, q.Cap())
+	}
+
+	q.PopBack()
+	if q.Cap() != 2 {
+		t.Errorf("Expected the capacity to shrink to %d but found %d", 2, q.Cap())
+	}
+
+	q.PopBack()
+	if q.Cap() != 1 {
+		t.Errorf("Expected the capacity to shrink all the way down to the floor of %d but found %d", 1, q.Cap())
+	}
+	if q.Len() != 0 {
+		t.Errorf("Expected the deque to be empty but found length %d", q.Len())
+	}
+}
+
+func TestShrinkNeverGoesBelowConfiguredFloor(t *testing.T) {
+	q := WithCapacity[int](8)
+	q.SetMinCapacity(3) // floor of 8
+	for i := 0; i < 2000; i++ {
+		q.PushBack(i)
+	}
+	if q.Cap() < 1024 {
+		t.Errorf("Expected the capacity to have grown past %d, but found %d", 1024, q.Cap())
+	}
+
+	for q.Len() > 0 {
+		q.PopBack()
+	}
+
+	if q.Cap() != 8 {
+		t.Errorf("Expected the capacity to shrink back down to the configured floor of %d but found %d", 8, q.Cap())
+	}
+}
+
+func TestSetMinCapacityRoundsUpToPowerOfTwo(t *testing.T) {
+	q := new(Deque[int])
+	q.SetMinCapacity(5)
+	if q.minCapacity != 32 {
+		t.Errorf("Expected the floor to be %d but found %d", 32, q.minCapacity)
+	}
+}
+
+func TestSetMinCapacityOnNilDequeDoesNotPanic(t *testing.T) {
+	var q *Deque[int] = nil
+	q.SetMinCapacity(4)
+}
+
+func TestClearResetsLenAndKeepsCapacityWithinFloor(t *testing.T) {
+	q, capacity := setupDequeWithItems()
+	q.Clear()
+	if q.Len() != 0 {
+		t.Errorf("Expected length 0 after Clear but found %d", q.Len())
+	}
+	if q.Cap() != capacity {
+		t.Errorf("Expected the capacity to remain %d since it never grew past the floor, but found %d", capacity, q.Cap())
+	}
+	if q.Contains(1, intEq) {
+		t.Error("Expected Clear to remove all elements, but the deque still contains 1")
+	}
+}
+
+func TestClearShrinksBufferAboveFloor(t *testing.T) {
+	q := WithCapacity[int](4)
+	q.SetMinCapacity(0) // floor of 1, so any growth is clearly above it
+	for _, v := range []int{1, 2, 3, 4, 5} {
+		q.PushBack(v)
+	}
+	if q.Cap() != 8 {
+		t.Errorf("Expected the capacity to have grown to %d but found %d", 8, q.Cap())
+	}
+
+	q.Clear()
+	if q.Cap() != 1 {
+		t.Errorf("Expected Clear to shrink the capacity back down to the configured floor of %d but found %d", 1, q.Cap())
+	}
+	if q.Len() != 0 {
+		t.Errorf("Expected length 0 after Clear but found %d", q.Len())
+	}
+	if q.Contains(1, intEq) {
+		t.Error("Expected Clear to remove all elements, but the deque still contains 1")
+	}
+}
+
+func TestClearOnNilDequeDoesNotPanic(t *testing.T) {
+	var q *Deque[int] = nil
+	q.Clear()
+}
+
+func TestAtMatchesHeadAndTail(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	if q.At(0) != q.Head() {
+		t.Errorf("Expected At(0) to equal Head() %d but found %d", q.Head(), q.At(0))
+	}
+	if q.At(q.Len()-1) != q.Tail() {
+		t.Errorf("Expected At(Len()-1) to equal Tail() %d but found %d", q.Tail(), q.At(q.Len()-1))
+	}
+	if q.At(3) != 4 {
+		t.Errorf("Expected At(3) to be %d but found %d", 4, q.At(3))
+	}
+}
+
+func TestAtPanicsOutOfRange(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected At to panic for an out-of-range index, but it did not")
+		}
+	}()
+	q.At(q.Len())
+}
+
+func TestAtPanicsOnNegativeIndex(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected At to panic for a negative index, but it did not")
+		}
+	}()
+	q.At(-1)
+}
+
+func TestSetWritesInPlace(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	q.Set(0, 99)
+	if q.At(0) != 99 {
+		t.Errorf("Expected At(0) to be %d after Set but found %d", 99, q.At(0))
+	}
+	if q.Head() != 99 {
+		t.Errorf("Expected Head() to reflect the Set value %d but found %d", 99, q.Head())
+	}
+}
+
+func TestSetPanicsOutOfRange(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected Set to panic for an out-of-range index, but it did not")
+		}
+	}()
+	q.Set(q.Len(), 1)
+}
+
+func TestInsertAtFrontIsPushFront(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	q.Insert(0, 0)
+	if q.Len() != 9 {
+		t.Errorf("Expected length %d but found %d", 9, q.Len())
+	}
+	if q.At(0) != 0 {
+		t.Errorf("Expected the inserted value at index 0 to be %d but found %d", 0, q.At(0))
+	}
+	if q.At(1) != 1 {
+		t.Errorf("Expected the original head to have shifted to index 1, but found %d", q.At(1))
+	}
+}
+
+func TestInsertAtEndIsPushBack(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	q.Insert(q.Len(), 9)
+	if q.Len() != 9 {
+		t.Errorf("Expected length %d but found %d", 9, q.Len())
+	}
+	if q.At(q.Len()-1) != 9 {
+		t.Errorf("Expected the inserted value at the end to be %d but found %d", 9, q.At(q.Len()-1))
+	}
+}
+
+func TestInsertNearFrontShiftsShorterSide(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	q.Insert(2, 99)
+	expected := []int{1, 2, 99, 3, 4, 5, 6, 7, 8}
+	for i, want := range expected {
+		if q.At(i) != want {
+			t.Errorf("Expected At(%d) to be %d but found %d", i, want, q.At(i))
+		}
+	}
+}
+
+func TestInsertNearBackShiftsShorterSide(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	q.Insert(6, 99)
+	expected := []int{1, 2, 3, 4, 5, 6, 99, 7, 8}
+	for i, want := range expected {
+		if q.At(i) != want {
+			t.Errorf("Expected At(%d) to be %d but found %d", i, want, q.At(i))
+		}
+	}
+}
+
+func TestInsertPanicsOutOfRange(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected Insert to panic for an out-of-range index, but it did not")
+		}
+	}()
+	q.Insert(q.Len()+1, 0)
+}
+
+func TestInsertGrowsWhenFull(t *testing.T) {
+	q := WithCapacity[int](4)
+	for _, v := range []int{1, 2, 3, 4} {
+		q.PushBack(v)
+	}
+	q.Insert(2, 99)
+	if q.Cap() != 8 {
+		t.Errorf("Expected Insert to grow the capacity to %d but found %d", 8, q.Cap())
+	}
+	expected := []int{1, 2, 99, 3, 4}
+	for i, want := range expected {
+		if q.At(i) != want {
+			t.Errorf("Expected At(%d) to be %d but found %d", i, want, q.At(i))
+		}
+	}
+}
+
+func TestRemoveNearFrontShiftsShorterSide(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	removed := q.Remove(2)
+	if removed != 3 {
+		t.Errorf("Expected the removed value to be %d but found %d", 3, removed)
+	}
+	expected := []int{1, 2, 4, 5, 6, 7, 8}
+	if q.Len() != len(expected) {
+		t.Errorf("Expected length %d but found %d", len(expected), q.Len())
+	}
+	for i, want := range expected {
+		if q.At(i) != want {
+			t.Errorf("Expected At(%d) to be %d but found %d", i, want, q.At(i))
+		}
+	}
+}
+
+func TestRemoveNearBackShiftsShorterSide(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	removed := q.Remove(5)
+	if removed != 6 {
+		t.Errorf("Expected the removed value to be %d but found %d", 6, removed)
+	}
+	expected := []int{1, 2, 3, 4, 5, 7, 8}
+	if q.Len() != len(expected) {
+		t.Errorf("Expected length %d but found %d", len(expected), q.Len())
+	}
+	for i, want := range expected {
+		if q.At(i) != want {
+			t.Errorf("Expected At(%d) to be %d but found %d", i, want, q.At(i))
+		}
+	}
+}
+
+func TestRemovePanicsOutOfRange(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected Remove to panic for an out-of-range index, but it did not")
+		}
+	}()
+	q.Remove(q.Len())
+}
+
+func TestRemoveShrinksWhenMostlyEmpty(t *testing.T) {
+	q := WithCapacity[int](4)
+	q.SetMinCapacity(0)
+	for _, v := range []int{1, 2, 3, 4, 5} {
+		q.PushBack(v)
+	}
+	if q.Cap() != 8 {
+		t.Errorf("Expected the capacity to have grown to %d but found %d", 8, q.Cap())
+	}
+
+	q.Remove(0)
+	q.Remove(0)
+	q.Remove(0)
+	if q.Cap() != 4 {
+		t.Errorf("Expected Remove to shrink the capacity to %d but found %d", 4, q.Cap())
+	}
+	if q.Len() != 2 {
+		t.Errorf("Expected length %d but found %d", 2, q.Len())
+	}
+}
+
+func TestIterYieldsLogicalOrder(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	got := make([]int, 0, q.Len())
+	for v := range q.Iter() {
+		got = append(got, v)
+	}
+	expected := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d items but found %d", len(expected), len(got))
+	}
+	for i, want := range expected {
+		if got[i] != want {
+			t.Errorf("Expected item %d at index %d but found %d", want, i, got[i])
+		}
+	}
+}
+
+func TestIterStopsEarly(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	got := make([]int, 0)
+	for v := range q.Iter() {
+		got = append(got, v)
+		if v == 3 {
+			break
+		}
+	}
+	expected := []int{1, 2, 3}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected iteration to stop after %d items but found %d", len(expected), len(got))
+	}
+}
+
+func TestIterBackYieldsReverseOrder(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	got := make([]int, 0, q.Len())
+	for v := range q.IterBack() {
+		got = append(got, v)
+	}
+	expected := []int{8, 7, 6, 5, 4, 3, 2, 1}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d items but found %d", len(expected), len(got))
+	}
+	for i, want := range expected {
+		if got[i] != want {
+			t.Errorf("Expected item %d at index %d but found %d", want, i, got[i])
+		}
+	}
+}
+
+func TestIterOnNilDequeYieldsNothing(t *testing.T) {
+	var q *Deque[int] = nil
+	count := 0
+	for range q.Iter() {
+		count++
+	}
+	if count != 0 {
+		t.Errorf("Expected no items from a nil Deque but found %d", count)
+	}
+}
+
+func TestRotatePositiveMovesFrontToBack(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	q.Rotate(2)
+	expected := []int{3, 4, 5, 6, 7, 8, 1, 2}
+	for i, want := range expected {
+		if q.At(i) != want {
+			t.Errorf("Expected At(%d) to be %d but found %d", i, want, q.At(i))
+		}
+	}
+	if q.Head() != 3 {
+		t.Errorf("Expected head to be %d but found %d", 3, q.Head())
+	}
+	if q.Tail() != 2 {
+		t.Errorf("Expected tail to be %d but found %d", 2, q.Tail())
+	}
+}
+
+func TestRotateNegativeMovesBackToFront(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	q.Rotate(-2)
+	expected := []int{7, 8, 1, 2, 3, 4, 5, 6}
+	for i, want := range expected {
+		if q.At(i) != want {
+			t.Errorf("Expected At(%d) to be %d but found %d", i, want, q.At(i))
+		}
+	}
+}
+
+func TestRotateByLenIsNoop(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	q.Rotate(q.Len())
+	expected := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	for i, want := range expected {
+		if q.At(i) != want {
+			t.Errorf("Expected At(%d) to be %d but found %d", i, want, q.At(i))
+		}
+	}
+}
+
+func TestRotateOnEmptyDequeDoesNotPanic(t *testing.T) {
+	q := WithCapacity[int](4)
+	q.Rotate(3)
+	if q.Len() != 0 {
+		t.Errorf("Expected length to remain 0 but found %d", q.Len())
+	}
+}
+
+func TestRotateAcrossWrapBoundaryThenIter(t *testing.T) {
+	q := WithCapacity[int](8)
+	for _, v := range []int{1, 2, 3, 4, 5, 6, 7, 8} {
+		q.PushBack(v)
+	}
+	// Pop the head and push a new tail so head/tail sit off of physical slot
+	// 0, meaning the rotation below has to cross the buffer's physical wrap
+	// boundary rather than just its logical one.
+	q.PopFront()
+	q.PushBack(9)
+
+	q.Rotate(3)
+
+	expected := []int{5, 6, 7, 8, 9, 2, 3, 4}
+	got := make([]int, 0, q.Len())
+	for v := range q.Iter() {
+		got = append(got, v)
+	}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d items but found %d", len(expected), len(got))
+	}
+	for i, want := range expected {
+		if got[i] != want {
+			t.Errorf("Expected item %d at index %d but found %d", want, i, got[i])
+		}
+	}
+}
+
+func TestRotateFullBufferAcrossWrapBoundary(t *testing.T) {
+	q := WithCapacity[int](8)
+	for _, v := range []int{1, 2, 3, 4, 5, 6, 7, 8} {
+		q.PushBack(v)
+	}
+	// Pop the head and push a new tail so head/tail sit off of physical slot
+	// 0 while the buffer is still completely full, exercising the O(1)
+	// pointer-shift path across the buffer's physical wrap boundary.
+	q.PopFront()
+	q.PushBack(9)
+
+	q.Rotate(-2)
+
+	expected := []int{8, 9, 2, 3, 4, 5, 6, 7}
+	got := make([]int, 0, q.Len())
+	for v := range q.Iter() {
+		got = append(got, v)
+	}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d items but found %d", len(expected), len(got))
+	}
+	for i, want := range expected {
+		if got[i] != want {
+			t.Errorf("Expected item %d at index %d but found %d", want, i, got[i])
+		}
+	}
+}
+
+func TestPushBackSliceGrowsOnceAndAppendsInOrder(t *testing.T) {
+	q := WithCapacity[int](4)
+	q.PushBack(1)
+	q.PushBackSlice([]int{2, 3, 4, 5, 6})
+	if q.Len() != 6 {
+		t.Errorf("Expected length %d but found %d", 6, q.Len())
+	}
+	if q.Cap() != 8 {
+		t.Errorf("Expected the capacity to grow to %d in one step but found %d", 8, q.Cap())
+	}
+	expected := []int{1, 2, 3, 4, 5, 6}
+	got := q.Slice()
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d items but found %d", len(expected), len(got))
+	}
+	for i, want := range expected {
+		if got[i] != want {
+			t.Errorf("Expected Slice()[%d] to be %d but found %d", i, want, got[i])
+		}
+	}
+}
+
+func TestPushBackSliceWrapsAroundBuffer(t *testing.T) {
+	q := WithCapacity[int](8)
+	for _, v := range []int{1, 2, 3, 4, 5, 6} {
+		q.PushBack(v)
+	}
+	q.PopFrontN(4)
+	q.PushBackSlice([]int{7, 8, 9, 10})
+
+	expected := []int{5, 6, 7, 8, 9, 10}
+	got := q.Slice()
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d items but found %d", len(expected), len(got))
+	}
+	for i, want := range expected {
+		if got[i] != want {
+			t.Errorf("Expected Slice()[%d] to be %d but found %d", i, want, got[i])
+		}
+	}
+}
+
+func TestPushBackSliceOnEmptySliceIsNoop(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	q.PushBackSlice(nil)
+	if q.Len() != 8 {
+		t.Errorf("Expected length to remain %d but found %d", 8, q.Len())
+	}
+}
+
+func TestPushFrontSliceGrowsOnceAndPrependsInOrder(t *testing.T) {
+	q := WithCapacity[int](4)
+	q.PushBack(10)
+	q.PushBack(20)
+	q.PushFrontSlice([]int{1, 2, 3})
+	if q.Cap() != 8 {
+		t.Errorf("Expected the capacity to grow to %d in one step but found %d", 8, q.Cap())
+	}
+	expected := []int{1, 2, 3, 10, 20}
+	got := q.Slice()
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d items but found %d", len(expected), len(got))
+	}
+	for i, want := range expected {
+		if got[i] != want {
+			t.Errorf("Expected Slice()[%d] to be %d but found %d", i, want, got[i])
+		}
+	}
+}
+
+func TestPopFrontNReturnsLogicalOrderAndClamps(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	got := q.PopFrontN(3)
+	expected := []int{1, 2, 3}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d items but found %d", len(expected), len(got))
+	}
+	for i, want := range expected {
+		if got[i] != want {
+			t.Errorf("Expected item %d at index %d but found %d", want, i, got[i])
+		}
+	}
+	if q.Len() != 5 {
+		t.Errorf("Expected length %d but found %d", 5, q.Len())
+	}
+	if q.Head() != 4 {
+		t.Errorf("Expected head to be %d but found %d", 4, q.Head())
+	}
+
+	got = q.PopFrontN(100)
+	if len(got) != 5 {
+		t.Errorf("Expected PopFrontN to clamp to the remaining %d items but found %d", 5, len(got))
+	}
+	if q.Len() != 0 {
+		t.Errorf("Expected the deque to be empty but found length %d", q.Len())
+	}
+}
+
+func TestPopFrontNNonPositiveReturnsNil(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	if got := q.PopFrontN(0); got != nil {
+		t.Errorf("Expected PopFrontN(0) to return nil but found %v", got)
+	}
+	if got := q.PopFrontN(-1); got != nil {
+		t.Errorf("Expected PopFrontN(-1) to return nil but found %v", got)
+	}
+	if q.Len() != 8 {
+		t.Errorf("Expected the deque to be untouched at length %d but found %d", 8, q.Len())
+	}
+}
+
+func TestPopFrontNOnNilDequeReturnsNil(t *testing.T) {
+	var q *Deque[int] = nil
+	if got := q.PopFrontN(3); got != nil {
+		t.Errorf("Expected PopFrontN on a nil Deque to return nil but found %v", got)
+	}
+}
+
+func TestPopFrontNShrinksOnceAtEnd(t *testing.T) {
+	q := WithCapacity[int](4)
+	q.SetMinCapacity(0)
+	for _, v := range []int{1, 2, 3, 4, 5} {
+		q.PushBack(v)
+	}
+	if q.Cap() != 8 {
+		t.Errorf("Expected the capacity to have grown to %d but found %d", 8, q.Cap())
+	}
+
+	got := q.PopFrontN(4)
+	expected := []int{1, 2, 3, 4}
+	for i, want := range expected {
+		if got[i] != want {
+			t.Errorf("Expected item %d at index %d but found %d", want, i, got[i])
+		}
+	}
+	if q.Cap() != 4 {
+		t.Errorf("Expected a single shrink step to %d but found %d", 4, q.Cap())
+	}
+	if q.Len() != 1 {
+		t.Errorf("Expected length %d but found %d", 1, q.Len())
+	}
+}
+
+func TestPopBackNReturnsLogicalOrderAndClamps(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	got := q.PopBackN(3)
+	expected := []int{6, 7, 8}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d items but found %d", len(expected), len(got))
+	}
+	for i, want := range expected {
+		if got[i] != want {
+			t.Errorf("Expected item %d at index %d but found %d", want, i, got[i])
+		}
+	}
+	if q.Len() != 5 {
+		t.Errorf("Expected length %d but found %d", 5, q.Len())
+	}
+	if q.Tail() != 5 {
+		t.Errorf("Expected tail to be %d but found %d", 5, q.Tail())
+	}
+
+	got = q.PopBackN(100)
+	if len(got) != 5 {
+		t.Errorf("Expected PopBackN to clamp to the remaining %d items but found %d", 5, len(got))
+	}
+	if q.Len() != 0 {
+		t.Errorf("Expected the deque to be empty but found length %d", q.Len())
+	}
+}
+
+func TestPopBackNOnNilDequeReturnsNil(t *testing.T) {
+	var q *Deque[int] = nil
+	if got := q.PopBackN(3); got != nil {
+		t.Errorf("Expected PopBackN on a nil Deque to return nil but found %v", got)
+	}
+}
+
+func TestSliceReturnsNewSliceInLogicalOrder(t *testing.T) {
+	q, _ := setupDequeWithItems()
+	got := q.Slice()
+	expected := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d items but found %d", len(expected), len(got))
+	}
+	for i, want := range expected {
+		if got[i] != want {
+			t.Errorf("Expected Slice()[%d] to be %d but found %d", i, want, got[i])
+		}
+	}
+
+	got[0] = 99
+	if q.At(0) == 99 {
+		t.Error("Expected Slice to return a copy, but mutating it changed the deque")
+	}
+}
+
+func TestSliceOnEmptyOrNilReturnsNil(t *testing.T) {
+	q := WithCapacity[int](4)
+	if got := q.Slice(); got != nil {
+		t.Errorf("Expected Slice on an empty Deque to return nil but found %v", got)
+	}
+
+	var nilQ *Deque[int] = nil
+	if got := nilQ.Slice(); got != nil {
+		t.Errorf("Expected Slice on a nil Deque to return nil but found %v", got)
+	}
+}
+
+func TestSliceAcrossWrapBoundary(t *testing.T) {
+	q := WithCapacity[int](8)
+	for _, v := range []int{1, 2, 3, 4, 5, 6, 7, 8} {
+		q.PushBack(v)
+	}
+	q.PopFront()
+	q.PushBack(9)
+
+	got := q.Slice()
+	expected := []int{2, 3, 4, 5, 6, 7, 8, 9}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d items but found %d", len(expected), len(got))
+	}
+	for i, want := range expected {
+		if got[i] != want {
+			t.Errorf("Expected Slice()[%d] to be %d but found %d", i, want, got[i])
+		}
+	}
+}